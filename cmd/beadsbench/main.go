@@ -0,0 +1,154 @@
+// Command beadsbench runs the project's benchmark suite, compares the
+// results against stored baselines, and fails with a diff table when any
+// benchmark has regressed.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/benchreport"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "beadsbench:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("beadsbench", flag.ExitOnError)
+	pkg := fs.String("pkg", "./...", "package pattern to benchmark, passed to go test")
+	benchFilter := fs.String("bench", ".", "benchmark name regex, passed to go test -bench")
+	baselineDir := fs.String("baseline-dir", "testdata/benchbaselines", "directory storing baseline JSON, one file per profile")
+	profiles := fs.String("profiles", "default", "comma-separated fixture profiles to run the suite under")
+	update := fs.Bool("update", false, "write a fresh baseline for each profile instead of comparing against it")
+	gitSHA := fs.String("git-sha", "", "git SHA to stamp the report with (defaults to `git rev-parse HEAD`)")
+	nsThreshold := fs.Float64("ns-threshold", benchreport.DefaultThresholds.NsPerOpPct, "fail when ns/op regresses by more than this fraction")
+	allocsThreshold := fs.Float64("allocs-threshold", benchreport.DefaultThresholds.AllocsPerOpPct, "fail when allocs/op regresses by more than this fraction")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	sha := *gitSHA
+	if sha == "" {
+		var err error
+		sha, err = currentGitSHA()
+		if err != nil {
+			return fmt.Errorf("failed to determine git SHA: %w", err)
+		}
+	}
+
+	thresholds := benchreport.Thresholds{
+		NsPerOpPct:     *nsThreshold,
+		AllocsPerOpPct: *allocsThreshold,
+	}
+
+	var profileList []string
+	for _, profile := range strings.Split(*profiles, ",") {
+		if profile = strings.TrimSpace(profile); profile != "" {
+			profileList = append(profileList, profile)
+		}
+	}
+	warnIfProfilesUnwired(profileList)
+
+	var regressed bool
+	for _, profile := range profileList {
+		report, err := runBenchmarks(*pkg, *benchFilter, profile, sha)
+		if err != nil {
+			return fmt.Errorf("profile %s: %w", profile, err)
+		}
+
+		path := benchreport.BaselinePath(*baselineDir, profile)
+		if *update {
+			if err := benchreport.SaveBaseline(path, report); err != nil {
+				return fmt.Errorf("profile %s: %w", profile, err)
+			}
+			fmt.Printf("updated baseline %s (%d benchmarks)\n", path, len(report.Results))
+			continue
+		}
+
+		baseline, err := benchreport.LoadBaseline(path)
+		if err != nil {
+			return fmt.Errorf("profile %s: no baseline to compare against (run with -update first): %w", profile, err)
+		}
+
+		regressions := benchreport.Compare(baseline, report, thresholds)
+		if len(regressions) == 0 {
+			fmt.Printf("profile %s: no regressions (%d benchmarks)\n", profile, len(report.Results))
+			continue
+		}
+
+		regressed = true
+		printRegressions(profile, regressions)
+	}
+
+	if regressed {
+		return fmt.Errorf("benchmark regressions detected")
+	}
+	return nil
+}
+
+// warnIfProfilesUnwired prints a stderr warning when the caller asked to
+// compare more than one fixture profile. fixtures.ProfileFromEnv exists for
+// a benchmark's setup helper to call so it builds its fixture under the
+// FixtureProfile named by BEADS_BENCH_FIXTURE_PROFILE (see
+// fixtures.ProfileByName for the accepted names), but none of today's
+// benchmarks do that yet — every setup helper still builds the same
+// default fixture regardless of BEADS_BENCH_FIXTURE_PROFILE, so
+// -profiles=default,deep-tree,... would otherwise silently produce
+// byte-identical baseline files and let a deep-tree-specific regression
+// hide behind a flat-graph baseline.
+func warnIfProfilesUnwired(profiles []string) {
+	if len(profiles) < 2 {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "beadsbench: warning: -profiles=%s requested, but no benchmark setup helper calls fixtures.ProfileFromEnv yet — all %d profiles will produce identical results\n",
+		strings.Join(profiles, ","), len(profiles))
+}
+
+// runBenchmarks runs `go test -bench` against pkg under the given fixture
+// profile and returns the parsed Report. See warnIfProfilesUnwired for why
+// this doesn't yet produce profile-specific results.
+func runBenchmarks(pkg, benchFilter, profile, gitSHA string) (benchreport.Report, error) {
+	cmd := exec.Command("go", "test", "-run=^$", "-bench="+benchFilter, "-benchmem", "-tags=bench", pkg)
+	cmd.Env = append(os.Environ(), "BEADS_BENCH_FIXTURE_PROFILE="+profile)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return benchreport.Report{}, fmt.Errorf("go test failed: %w", err)
+	}
+
+	results, err := benchreport.ParseOutput(&stdout)
+	if err != nil {
+		return benchreport.Report{}, fmt.Errorf("failed to parse benchmark output: %w", err)
+	}
+
+	return benchreport.Report{GitSHA: gitSHA, Profile: profile, Results: results}, nil
+}
+
+func printRegressions(profile string, regressions []benchreport.Regression) {
+	fmt.Printf("profile %s: %d regression(s)\n", profile, len(regressions))
+	fmt.Printf("  %-40s %-10s %14s %14s %8s\n", "benchmark", "metric", "baseline", "current", "delta")
+	for _, r := range regressions {
+		fmt.Printf("  %-40s %-10s %14.0f %14.0f %7.1f%%\n", r.Name, r.Metric, r.Baseline, r.Current, r.DeltaPct*100)
+	}
+}
+
+// currentGitSHA shells out to git since the module doesn't otherwise carry
+// version metadata.
+func currentGitSHA() (string, error) {
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,48 @@
+package benchreport
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// BaselinePath returns where a baseline for profile is stored under dir
+// (typically testdata/benchbaselines). One file per profile so a
+// flat-graph baseline never masks a deep-tree regression.
+func BaselinePath(dir, profile string) string {
+	return filepath.Join(dir, profile+".json")
+}
+
+// LoadBaseline reads and decodes the baseline Report stored at path.
+func LoadBaseline(path string) (Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to read baseline %s: %w", path, err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return Report{}, fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+	return report, nil
+}
+
+// SaveBaseline writes report to path as indented JSON, creating parent
+// directories as needed.
+func SaveBaseline(path string, report Report) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create baseline directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode baseline: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write baseline %s: %w", path, err)
+	}
+	return nil
+}
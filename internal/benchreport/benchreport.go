@@ -0,0 +1,155 @@
+// Package benchreport parses `go test -bench` output into structured
+// reports, persists them as baselines, and compares a fresh run against a
+// stored baseline to catch performance regressions.
+package benchreport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// Result holds the metrics testing.B reports for a single benchmark: its
+// name (including any -N GOMAXPROCS suffix added by the testing package),
+// time per operation, and allocation counts when -benchmem was used.
+type Result struct {
+	Name        string  `json:"name"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  int64   `json:"bytes_per_op"`
+	AllocsPerOp int64   `json:"allocs_per_op"`
+}
+
+// Report is a full benchmark run: every Result produced, keyed by the
+// fixture profile exercised and the git SHA the binary was built from.
+type Report struct {
+	GitSHA  string   `json:"git_sha"`
+	Profile string   `json:"profile"`
+	Results []Result `json:"results"`
+}
+
+// benchLineRE matches a standard `go test -bench -benchmem` output line:
+//
+//	BenchmarkFoo-8   	    1000	   1034231 ns/op	     456 B/op	       7 allocs/op
+var benchLineRE = regexp.MustCompile(
+	`^(Benchmark\S+)\s+\d+\s+([\d.]+)\s+ns/op(?:\s+([\d.]+)\s+B/op)?(?:\s+([\d.]+)\s+allocs/op)?`,
+)
+
+// ParseOutput reads `go test -bench` output from r and extracts one Result
+// per benchmark line. Lines that aren't benchmark results (PASS, ok,
+// compiler warnings, etc.) are ignored.
+func ParseOutput(r io.Reader) ([]Result, error) {
+	var results []Result
+
+	scanner := bufio.NewScanner(r)
+	// go test can emit long lines when -v is combined with -bench; keep the
+	// same generous buffer the fixtures importer uses.
+	scanner.Buffer(make([]byte, 0, 64*1024), 8*1024*1024)
+
+	for scanner.Scan() {
+		match := benchLineRE.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		nsPerOp, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ns/op for %s: %w", match[1], err)
+		}
+
+		result := Result{Name: match[1], NsPerOp: nsPerOp}
+		if match[3] != "" {
+			bytesPerOp, err := strconv.ParseFloat(match[3], 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse B/op for %s: %w", match[1], err)
+			}
+			result.BytesPerOp = int64(bytesPerOp)
+		}
+		if match[4] != "" {
+			allocsPerOp, err := strconv.ParseFloat(match[4], 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse allocs/op for %s: %w", match[1], err)
+			}
+			result.AllocsPerOp = int64(allocsPerOp)
+		}
+
+		results = append(results, result)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan benchmark output: %w", err)
+	}
+
+	return results, nil
+}
+
+// Thresholds bounds how much a metric may regress before Compare reports
+// it. Each is a fraction (0.15 means 15%).
+type Thresholds struct {
+	NsPerOpPct     float64
+	AllocsPerOpPct float64
+}
+
+// DefaultThresholds matches the regression budget benchmark authors expect:
+// 15% slower or 25% more allocations is a real regression, not noise.
+var DefaultThresholds = Thresholds{
+	NsPerOpPct:     0.15,
+	AllocsPerOpPct: 0.25,
+}
+
+// Regression describes one benchmark whose metric moved past Thresholds
+// between baseline and current.
+type Regression struct {
+	Name     string
+	Metric   string
+	Baseline float64
+	Current  float64
+	DeltaPct float64
+}
+
+// Compare diffs current against baseline using thresholds, returning one
+// Regression per (benchmark, metric) pair that regressed beyond budget.
+// Benchmarks present in current but missing from baseline are skipped (new
+// benchmarks have nothing to regress against); benchmarks missing from
+// current are likewise skipped so a -bench filter doesn't read as a mass
+// regression.
+func Compare(baseline, current Report, thresholds Thresholds) []Regression {
+	baseByName := make(map[string]Result, len(baseline.Results))
+	for _, r := range baseline.Results {
+		baseByName[r.Name] = r
+	}
+
+	var regressions []Regression
+	for _, cur := range current.Results {
+		base, ok := baseByName[cur.Name]
+		if !ok {
+			continue
+		}
+
+		if reg, ok := regressionFor(cur.Name, "ns/op", base.NsPerOp, cur.NsPerOp, thresholds.NsPerOpPct); ok {
+			regressions = append(regressions, reg)
+		}
+		if reg, ok := regressionFor(cur.Name, "allocs/op", float64(base.AllocsPerOp), float64(cur.AllocsPerOp), thresholds.AllocsPerOpPct); ok {
+			regressions = append(regressions, reg)
+		}
+	}
+
+	return regressions
+}
+
+func regressionFor(name, metric string, baseline, current, thresholdPct float64) (Regression, bool) {
+	if baseline <= 0 {
+		return Regression{}, false
+	}
+	deltaPct := (current - baseline) / baseline
+	if deltaPct <= thresholdPct {
+		return Regression{}, false
+	}
+	return Regression{
+		Name:     name,
+		Metric:   metric,
+		Baseline: baseline,
+		Current:  current,
+		DeltaPct: deltaPct,
+	}, true
+}
@@ -0,0 +1,134 @@
+package benchreport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseOutput(t *testing.T) {
+	const output = `goos: linux
+goarch: amd64
+pkg: github.com/steveyegge/beads/internal/storage/sqlite
+BenchmarkGetReadyWork_Large-8      	    1000	   1034231 ns/op	     456 B/op	       7 allocs/op
+BenchmarkCreateIssue_Large-8       	    5000	    201456 ns/op
+BenchmarkSearchIssues_Large_NoFilter-8	    2000	    512000.5 ns/op	     128.0 B/op	       3.0 allocs/op
+PASS
+ok  	github.com/steveyegge/beads/internal/storage/sqlite	4.812s
+`
+
+	results, err := ParseOutput(strings.NewReader(output))
+	if err != nil {
+		t.Fatalf("ParseOutput: %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3: %+v", len(results), results)
+	}
+
+	want := Result{Name: "BenchmarkGetReadyWork_Large-8", NsPerOp: 1034231, BytesPerOp: 456, AllocsPerOp: 7}
+	if results[0] != want {
+		t.Errorf("results[0] = %+v, want %+v", results[0], want)
+	}
+
+	// No -benchmem: B/op and allocs/op are left at their zero value rather
+	// than erroring.
+	want = Result{Name: "BenchmarkCreateIssue_Large-8", NsPerOp: 201456}
+	if results[1] != want {
+		t.Errorf("results[1] = %+v, want %+v", results[1], want)
+	}
+
+	want = Result{Name: "BenchmarkSearchIssues_Large_NoFilter-8", NsPerOp: 512000.5, BytesPerOp: 128, AllocsPerOp: 3}
+	if results[2] != want {
+		t.Errorf("results[2] = %+v, want %+v", results[2], want)
+	}
+}
+
+func TestParseOutputNoBenchmarks(t *testing.T) {
+	const output = "PASS\nok  \tgithub.com/steveyegge/beads/internal/benchreport\t0.002s\n"
+
+	results, err := ParseOutput(strings.NewReader(output))
+	if err != nil {
+		t.Fatalf("ParseOutput: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("got %d results, want 0: %+v", len(results), results)
+	}
+}
+
+func TestRegressionFor(t *testing.T) {
+	cases := []struct {
+		name              string
+		baseline, current float64
+		thresholdPct      float64
+		wantRegression    bool
+		wantDeltaPct      float64
+	}{
+		{name: "under threshold", baseline: 100, current: 110, thresholdPct: 0.15, wantRegression: false},
+		{name: "exactly at threshold", baseline: 100, current: 115, thresholdPct: 0.15, wantRegression: false},
+		{name: "over threshold", baseline: 100, current: 116, thresholdPct: 0.15, wantRegression: true, wantDeltaPct: 0.16},
+		{name: "improvement is never a regression", baseline: 100, current: 50, thresholdPct: 0.15, wantRegression: false},
+		{name: "zero baseline is skipped", baseline: 0, current: 1000, thresholdPct: 0.15, wantRegression: false},
+		{name: "negative baseline is skipped", baseline: -5, current: 10, thresholdPct: 0.15, wantRegression: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			reg, ok := regressionFor("BenchmarkFoo", "ns/op", tc.baseline, tc.current, tc.thresholdPct)
+			if ok != tc.wantRegression {
+				t.Fatalf("regressionFor() ok = %v, want %v (reg=%+v)", ok, tc.wantRegression, reg)
+			}
+			if !ok {
+				return
+			}
+			if reg.Name != "BenchmarkFoo" || reg.Metric != "ns/op" {
+				t.Errorf("reg = %+v, want Name/Metric BenchmarkFoo/ns/op", reg)
+			}
+			if reg.Baseline != tc.baseline || reg.Current != tc.current {
+				t.Errorf("reg.Baseline/Current = %v/%v, want %v/%v", reg.Baseline, reg.Current, tc.baseline, tc.current)
+			}
+			if reg.DeltaPct < tc.wantDeltaPct-0.0001 || reg.DeltaPct > tc.wantDeltaPct+0.0001 {
+				t.Errorf("reg.DeltaPct = %v, want ~%v", reg.DeltaPct, tc.wantDeltaPct)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := Report{
+		Profile: "default",
+		Results: []Result{
+			{Name: "BenchmarkA", NsPerOp: 100, AllocsPerOp: 10},
+			{Name: "BenchmarkB", NsPerOp: 100, AllocsPerOp: 10},
+			{Name: "BenchmarkGoneFromCurrent", NsPerOp: 100, AllocsPerOp: 10},
+		},
+	}
+	current := Report{
+		Profile: "default",
+		Results: []Result{
+			{Name: "BenchmarkA", NsPerOp: 100, AllocsPerOp: 10},             // unchanged
+			{Name: "BenchmarkB", NsPerOp: 200, AllocsPerOp: 20},             // regressed on both metrics
+			{Name: "BenchmarkNewInCurrent", NsPerOp: 999, AllocsPerOp: 999}, // no baseline, skipped
+		},
+	}
+
+	regressions := Compare(baseline, current, DefaultThresholds)
+
+	if len(regressions) != 2 {
+		t.Fatalf("got %d regressions, want 2: %+v", len(regressions), regressions)
+	}
+
+	byMetric := make(map[string]Regression, len(regressions))
+	for _, r := range regressions {
+		if r.Name != "BenchmarkB" {
+			t.Fatalf("unexpected regression for %s: %+v", r.Name, r)
+		}
+		byMetric[r.Metric] = r
+	}
+
+	if _, ok := byMetric["ns/op"]; !ok {
+		t.Error("expected a ns/op regression for BenchmarkB")
+	}
+	if _, ok := byMetric["allocs/op"]; !ok {
+		t.Error("expected an allocs/op regression for BenchmarkB")
+	}
+}
@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// TuningOptions describes the PRAGMAs applyTuning runs against a database.
+// It's a plain value (not global state) so tests and benchmarks can compare
+// configurations within a single run. Nothing in this package applies it on
+// connection open: applyTuning has no caller here.
+type TuningOptions struct {
+	// JournalMode is the SQLite journal_mode PRAGMA value, e.g. "WAL" or
+	// "DELETE".
+	JournalMode string
+
+	// Synchronous is the synchronous PRAGMA value, e.g. "NORMAL" or "FULL".
+	Synchronous string
+
+	// CacheSizeKB is the cache_size PRAGMA value in kibibytes. SQLite
+	// expects a negative value here to mean "KB"; callers pass a positive
+	// size and applyTuning negates it.
+	CacheSizeKB int
+
+	// MmapSizeBytes is the mmap_size PRAGMA value in bytes.
+	MmapSizeBytes int64
+
+	// TempStore is the temp_store PRAGMA value, e.g. "MEMORY" or "FILE".
+	TempStore string
+
+	// PageSize is the page_size PRAGMA value in bytes. Only takes effect on
+	// a freshly created database file.
+	PageSize int
+
+	// WALAutocheckpointPages is the wal_autocheckpoint PRAGMA value, in
+	// pages. Zero leaves SQLite's own setting in place (no PRAGMA is run).
+	WALAutocheckpointPages int
+}
+
+// PresetDefault mirrors SQLite's own defaults: rollback journal and full
+// synchronous durability, no mmap.
+var PresetDefault = TuningOptions{
+	JournalMode: "DELETE",
+	Synchronous: "FULL",
+	CacheSizeKB: 2000,
+	TempStore:   "DEFAULT",
+}
+
+// PresetWALTuned favors throughput: WAL journaling with synchronous=NORMAL,
+// a generous cache and mmap window, and temp tables kept in memory.
+var PresetWALTuned = TuningOptions{
+	JournalMode:            "WAL",
+	Synchronous:            "NORMAL",
+	CacheSizeKB:            64000,
+	MmapSizeBytes:          256 << 20,
+	TempStore:              "MEMORY",
+	WALAutocheckpointPages: 1000,
+}
+
+// PresetDurable keeps WAL's read concurrency but restores full fsync
+// durability, for callers that can't tolerate losing the last few
+// transactions on a crash.
+var PresetDurable = TuningOptions{
+	JournalMode:            "WAL",
+	Synchronous:            "FULL",
+	CacheSizeKB:            64000,
+	MmapSizeBytes:          256 << 20,
+	TempStore:              "MEMORY",
+	WALAutocheckpointPages: 1000,
+}
+
+// applyTuning runs opts as a sequence of PRAGMA statements against db,
+// skipping every field left at its zero value. Each statement runs through
+// db.Exec, so it reaches whichever pooled connection serves that call; no
+// store in this package calls applyTuning when it opens its database.
+func applyTuning(db *sql.DB, opts TuningOptions) error {
+	pragmas := []string{}
+
+	if opts.JournalMode != "" {
+		pragmas = append(pragmas, fmt.Sprintf("PRAGMA journal_mode = %s", opts.JournalMode))
+	}
+	if opts.Synchronous != "" {
+		pragmas = append(pragmas, fmt.Sprintf("PRAGMA synchronous = %s", opts.Synchronous))
+	}
+	if opts.CacheSizeKB != 0 {
+		pragmas = append(pragmas, fmt.Sprintf("PRAGMA cache_size = -%d", opts.CacheSizeKB))
+	}
+	if opts.MmapSizeBytes != 0 {
+		pragmas = append(pragmas, fmt.Sprintf("PRAGMA mmap_size = %d", opts.MmapSizeBytes))
+	}
+	if opts.TempStore != "" {
+		pragmas = append(pragmas, fmt.Sprintf("PRAGMA temp_store = %s", opts.TempStore))
+	}
+	if opts.PageSize != 0 {
+		pragmas = append(pragmas, fmt.Sprintf("PRAGMA page_size = %d", opts.PageSize))
+	}
+	if opts.WALAutocheckpointPages != 0 {
+		pragmas = append(pragmas, fmt.Sprintf("PRAGMA wal_autocheckpoint = %d", opts.WALAutocheckpointPages))
+	}
+
+	for _, pragma := range pragmas {
+		if _, err := db.Exec(pragma); err != nil {
+			return fmt.Errorf("failed to apply tuning pragma %q: %w", pragma, err)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@
 package fixtures
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -90,26 +91,30 @@ var taskTitles = []string{
 
 // LargeSQLite creates a 10K issue database with realistic patterns
 func LargeSQLite(ctx context.Context, store storage.Storage) error {
-	return generateIssues(ctx, store, 10000, rand.New(rand.NewSource(42)))
+	return generateIssues(ctx, store, 10000, rand.New(rand.NewSource(42)), GenerateOptions{})
 }
 
 // XLargeSQLite creates a 20K issue database with realistic patterns
 func XLargeSQLite(ctx context.Context, store storage.Storage) error {
-	return generateIssues(ctx, store, 20000, rand.New(rand.NewSource(43)))
+	return generateIssues(ctx, store, 20000, rand.New(rand.NewSource(43)), GenerateOptions{})
 }
 
 // LargeFromJSONL creates a 10K issue database by exporting to JSONL and reimporting
 func LargeFromJSONL(ctx context.Context, store storage.Storage, tempDir string) error {
-	return generateFromJSONL(ctx, store, tempDir, 10000, rand.New(rand.NewSource(44)))
+	return generateFromJSONL(ctx, store, tempDir, 10000, rand.New(rand.NewSource(44)), GenerateOptions{})
 }
 
 // XLargeFromJSONL creates a 20K issue database by exporting to JSONL and reimporting
 func XLargeFromJSONL(ctx context.Context, store storage.Storage, tempDir string) error {
-	return generateFromJSONL(ctx, store, tempDir, 20000, rand.New(rand.NewSource(45)))
+	return generateFromJSONL(ctx, store, tempDir, 20000, rand.New(rand.NewSource(45)), GenerateOptions{})
 }
 
-// generateIssues creates n issues with realistic epic hierarchies and cross-links
-func generateIssues(ctx context.Context, store storage.Storage, n int, rng *rand.Rand) error {
+// generateIssues creates n issues with realistic epic hierarchies and
+// cross-links, reporting progress through opts.Progress (a no-op by
+// default).
+func generateIssues(ctx context.Context, store storage.Storage, n int, rng *rand.Rand, opts GenerateOptions) error {
+	progress := progressOrDefault(opts.Progress)
+
 	// Calculate breakdown: 10% epics, 30% features, 60% tasks
 	numEpics := n / 10
 	numFeatures := (n * 3) / 10
@@ -121,18 +126,7 @@ func generateIssues(ctx context.Context, store storage.Storage, n int, rng *rand
 	featureIssues := make([]*types.Issue, 0, numFeatures)
 	taskIssues := make([]*types.Issue, 0, numTasks)
 
-	// Progress tracking
-	totalIssues := n
-	createdIssues := 0
-	lastPctLogged := -1
-
-	logProgress := func() {
-		pct := (createdIssues * 100) / totalIssues
-		if pct >= lastPctLogged+10 {
-			fmt.Printf("  Progress: %d%% (%d/%d issues created)\n", pct, createdIssues, totalIssues)
-			lastPctLogged = pct
-		}
-	}
+	progress.Start(int64(n))
 
 	// Create epics
 	for i := 0; i < numEpics; i++ {
@@ -164,8 +158,7 @@ func generateIssues(ctx context.Context, store storage.Storage, n int, rng *rand
 
 		epicIssues = append(epicIssues, issue)
 		allIssues = append(allIssues, issue)
-		createdIssues++
-		logProgress()
+		progress.Add(1)
 	}
 
 	// Create features under epics
@@ -212,8 +205,7 @@ func generateIssues(ctx context.Context, store storage.Storage, n int, rng *rand
 
 		featureIssues = append(featureIssues, issue)
 		allIssues = append(allIssues, issue)
-		createdIssues++
-		logProgress()
+		progress.Add(1)
 	}
 
 	// Create tasks under features
@@ -260,11 +252,10 @@ func generateIssues(ctx context.Context, store storage.Storage, n int, rng *rand
 
 		taskIssues = append(taskIssues, issue)
 		allIssues = append(allIssues, issue)
-		createdIssues++
-		logProgress()
+		progress.Add(1)
 	}
 
-	fmt.Printf("  Progress: 100%% (%d/%d issues created) - Complete!\n", totalIssues, totalIssues)
+	progress.Finish()
 
 	// Add cross-links: 20% of tasks block other tasks across epics
 	numCrossLinks := numTasks / 5
@@ -292,16 +283,17 @@ func generateIssues(ctx context.Context, store storage.Storage, n int, rng *rand
 	return nil
 }
 
-// generateFromJSONL creates issues, exports to JSONL, clears DB, and reimports
-func generateFromJSONL(ctx context.Context, store storage.Storage, tempDir string, n int, rng *rand.Rand) error {
+// generateFromJSONL creates issues, exports to JSONL, clears DB, and
+// reimports, reporting progress for each stage through opts.Progress.
+func generateFromJSONL(ctx context.Context, store storage.Storage, tempDir string, n int, rng *rand.Rand, opts GenerateOptions) error {
 	// First generate issues normally
-	if err := generateIssues(ctx, store, n, rng); err != nil {
+	if err := generateIssues(ctx, store, n, rng, opts); err != nil {
 		return fmt.Errorf("failed to generate issues: %w", err)
 	}
 
 	// Export to JSONL
 	jsonlPath := filepath.Join(tempDir, "issues.jsonl")
-	if err := exportToJSONL(ctx, store, jsonlPath); err != nil {
+	if err := exportToJSONL(ctx, store, jsonlPath, opts.Progress); err != nil {
 		return fmt.Errorf("failed to export to JSONL: %w", err)
 	}
 
@@ -318,15 +310,18 @@ func generateFromJSONL(ctx context.Context, store storage.Storage, tempDir strin
 	}
 
 	// Import from JSONL
-	if err := importFromJSONL(ctx, store, jsonlPath); err != nil {
+	if err := importFromJSONLOptions(ctx, store, jsonlPath, ImportOptions{Progress: opts.Progress}); err != nil {
 		return fmt.Errorf("failed to import from JSONL: %w", err)
 	}
 
 	return nil
 }
 
-// exportToJSONL exports all issues to a JSONL file
-func exportToJSONL(ctx context.Context, store storage.Storage, path string) error {
+// exportToJSONL exports all issues to a JSONL file, reporting progress
+// through progress (a no-op if nil).
+func exportToJSONL(ctx context.Context, store storage.Storage, path string, progress Progress) error {
+	progress = progressOrDefault(progress)
+
 	// Get all issues
 	allIssues, err := store.SearchIssues(ctx, "", types.IssueFilter{})
 	if err != nil {
@@ -356,100 +351,300 @@ func exportToJSONL(ctx context.Context, store storage.Storage, path string) erro
 	}
 	defer f.Close()
 
+	progress.Start(int64(len(allIssues)))
 	encoder := json.NewEncoder(f)
 	for _, issue := range allIssues {
 		if err := encoder.Encode(issue); err != nil {
 			return fmt.Errorf("failed to encode issue: %w", err)
 		}
+		progress.Add(1)
 	}
+	progress.Finish()
 
 	return nil
 }
 
-// importFromJSONL imports issues from a JSONL file
+// defaultImportBatchSize is the number of issues (or dependency/label sets)
+// buffered before a flush when the caller doesn't specify one. A flush is
+// only a single transaction against a store that implements bulkIssueCreator
+// / bulkDependencyAdder (see below); against one that doesn't, it's still
+// one CreateIssue/AddDependency call per row, just streamed in chunks
+// instead of loaded fully into memory up front.
+const defaultImportBatchSize = 500
+
+// maxScannedLineBytes bounds the longest JSONL line the streaming importer
+// will accept; fixtures can carry long descriptions, so this is generous.
+const maxScannedLineBytes = 8 * 1024 * 1024
+
+// ImportOptions controls how importFromJSONL streams and batches writes.
+type ImportOptions struct {
+	// BatchSize is the number of issues (or dependency/label sets) buffered
+	// before a flush. Defaults to defaultImportBatchSize. See
+	// defaultImportBatchSize for what a flush actually does against today's
+	// stores.
+	BatchSize int
+
+	// Progress reports import progress, one unit per issue line processed
+	// in each pass. Defaults to a no-op.
+	Progress Progress
+}
+
+func (o ImportOptions) withDefaults() ImportOptions {
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultImportBatchSize
+	}
+	return o
+}
+
+// bulkIssueCreator is implemented by storage backends that can create many
+// issues in a single transaction. Backends that don't implement it fall back
+// to one CreateIssue call per issue. No backend in this snapshot implements
+// it yet, so importIssuesPass's batching is a memory/streaming win today,
+// not yet a transaction-count win.
+type bulkIssueCreator interface {
+	BulkCreateIssues(ctx context.Context, issues []*types.Issue, actor string) error
+}
+
+// bulkDependencyAdder is the dependency-batch counterpart to
+// bulkIssueCreator, with the same "no backend implements it yet" caveat.
+type bulkDependencyAdder interface {
+	BulkAddDependencies(ctx context.Context, deps []*types.Dependency, actor string) error
+}
+
+// importFromJSONL imports issues from a JSONL file. It streams the file
+// rather than loading it fully into memory, and flushes writes to storage in
+// batches (see defaultImportBatchSize for what "batch" means against
+// today's stores). It runs in two passes: pass 1 creates every issue
+// stripped of its dependencies and labels, pass 2 streams the file again and
+// attaches dependencies and labels now that every issue exists, so forward
+// references and cross-links resolve correctly. Against a store that
+// implements neither bulkIssueCreator nor bulkDependencyAdder, per-row
+// errors are still matched on their text: "UNIQUE constraint failed",
+// "already exists" and "cycle" errors are skipped.
 func importFromJSONL(ctx context.Context, store storage.Storage, path string) error {
-	// Read JSONL file
-	data, err := os.ReadFile(path)
+	return importFromJSONLOptions(ctx, store, path, ImportOptions{})
+}
+
+// importFromJSONLOptions is importFromJSONL with an explicit ImportOptions.
+func importFromJSONLOptions(ctx context.Context, store storage.Storage, path string, opts ImportOptions) error {
+	opts = opts.withDefaults()
+	progress := progressOrDefault(opts.Progress)
+
+	total, err := countJSONLLines(path)
 	if err != nil {
-		return fmt.Errorf("failed to read JSONL file: %w", err)
+		return fmt.Errorf("failed to count JSONL lines: %w", err)
 	}
 
-	// Parse issues
-	var issues []*types.Issue
-	lines := string(data)
-	for i, line := range splitLines(lines) {
-		if len(line) == 0 {
-			continue
-		}
+	progress.Start(total)
+	if err := importIssuesPass(ctx, store, path, opts, progress); err != nil {
+		return fmt.Errorf("failed to import issues: %w", err)
+	}
+	progress.Finish()
 
-		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
-			return fmt.Errorf("failed to parse issue at line %d: %w", i+1, err)
+	progress.Start(total)
+	if err := importLinksPass(ctx, store, path, opts, progress); err != nil {
+		return fmt.Errorf("failed to import dependencies and labels: %w", err)
+	}
+	progress.Finish()
+
+	return nil
+}
+
+// countJSONLLines counts the non-empty lines in path so importFromJSONL can
+// report progress against a known total without loading the file.
+func countJSONLLines(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open JSONL file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxScannedLineBytes)
+
+	var total int64
+	for scanner.Scan() {
+		if len(scanner.Bytes()) > 0 {
+			total++
 		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to scan JSONL file: %w", err)
+	}
+	return total, nil
+}
 
-		issues = append(issues, &issue)
+// importIssuesPass streams path and creates each issue (without its
+// dependencies/labels) in batches of opts.BatchSize.
+func importIssuesPass(ctx context.Context, store storage.Storage, path string, opts ImportOptions, progress Progress) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open JSONL file: %w", err)
 	}
+	defer f.Close()
 
-	// Import issues directly using storage interface
-	// Step 1: Create all issues first (without dependencies/labels)
-	type savedMetadata struct {
-		deps   []*types.Dependency
-		labels []string
+	bulk, _ := store.(bulkIssueCreator)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxScannedLineBytes)
+
+	batch := make([]*types.Issue, 0, opts.BatchSize)
+	lineNum := 0
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if bulk != nil {
+			if err := bulk.BulkCreateIssues(ctx, batch, "fixture"); err != nil {
+				return fmt.Errorf("failed to bulk create issues: %w", err)
+			}
+		} else {
+			for _, issue := range batch {
+				if err := store.CreateIssue(ctx, issue, "fixture"); err != nil {
+					// Ignore duplicate errors
+					if !strings.Contains(err.Error(), "UNIQUE constraint failed") {
+						return fmt.Errorf("failed to create issue %s: %w", issue.ID, err)
+					}
+				}
+			}
+		}
+		batch = batch[:0]
+		return nil
 	}
-	metadata := make(map[string]savedMetadata)
 
-	for _, issue := range issues {
-		// Save dependencies and labels for later
-		metadata[issue.ID] = savedMetadata{
-			deps:   issue.Dependencies,
-			labels: issue.Labels,
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
 		}
+
+		var issue types.Issue
+		if err := json.Unmarshal(line, &issue); err != nil {
+			return fmt.Errorf("failed to parse issue at line %d: %w", lineNum, err)
+		}
+		// Dependencies/labels are re-attached in the second pass, once every
+		// issue is guaranteed to exist.
 		issue.Dependencies = nil
 		issue.Labels = nil
 
-		if err := store.CreateIssue(ctx, issue, "fixture"); err != nil {
-			// Ignore duplicate errors
-			if !strings.Contains(err.Error(), "UNIQUE constraint failed") {
-				return fmt.Errorf("failed to create issue %s: %w", issue.ID, err)
+		batch = append(batch, &issue)
+		progress.Add(1)
+		if len(batch) >= opts.BatchSize {
+			if err := flush(); err != nil {
+				return err
 			}
 		}
 	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to scan JSONL file: %w", err)
+	}
+	return flush()
+}
+
+// importLinksPass re-streams path and attaches dependencies and labels in
+// batches of opts.BatchSize, now that every issue created by importIssuesPass
+// exists.
+func importLinksPass(ctx context.Context, store storage.Storage, path string, opts ImportOptions, progress Progress) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open JSONL file: %w", err)
+	}
+	defer f.Close()
 
-	// Step 2: Add all dependencies (now that all issues exist)
-	for issueID, meta := range metadata {
-		for _, dep := range meta.deps {
-			if err := store.AddDependency(ctx, dep, "fixture"); err != nil {
-				// Ignore duplicate and cycle errors
-				if !strings.Contains(err.Error(), "already exists") &&
-					!strings.Contains(err.Error(), "cycle") {
-					return fmt.Errorf("failed to add dependency for %s: %w", issueID, err)
+	bulkDeps, _ := store.(bulkDependencyAdder)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxScannedLineBytes)
+
+	depBatch := make([]*types.Dependency, 0, opts.BatchSize)
+	type labelSet struct {
+		issueID string
+		label   string
+	}
+	labelBatch := make([]labelSet, 0, opts.BatchSize)
+	lineNum := 0
+
+	flushDeps := func() error {
+		if len(depBatch) == 0 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if bulkDeps != nil {
+			if err := bulkDeps.BulkAddDependencies(ctx, depBatch, "fixture"); err != nil {
+				return fmt.Errorf("failed to bulk add dependencies: %w", err)
+			}
+		} else {
+			for _, dep := range depBatch {
+				if err := store.AddDependency(ctx, dep, "fixture"); err != nil {
+					// Ignore duplicate and cycle errors
+					if !strings.Contains(err.Error(), "already exists") &&
+						!strings.Contains(err.Error(), "cycle") {
+						return fmt.Errorf("failed to add dependency for %s: %w", dep.IssueID, err)
+					}
 				}
 			}
 		}
+		depBatch = depBatch[:0]
+		return nil
+	}
 
-		// Add labels
-		for _, label := range meta.labels {
-			_ = store.AddLabel(ctx, issueID, label, "fixture")
+	flushLabels := func() error {
+		if len(labelBatch) == 0 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
 		}
+		for _, ls := range labelBatch {
+			_ = store.AddLabel(ctx, ls.issueID, ls.label, "fixture")
+		}
+		labelBatch = labelBatch[:0]
+		return nil
 	}
 
-	return nil
-}
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var issue types.Issue
+		if err := json.Unmarshal(line, &issue); err != nil {
+			return fmt.Errorf("failed to parse issue at line %d: %w", lineNum, err)
+		}
+
+		depBatch = append(depBatch, issue.Dependencies...)
+		for _, label := range issue.Labels {
+			labelBatch = append(labelBatch, labelSet{issueID: issue.ID, label: label})
+		}
+		progress.Add(1)
 
-// splitLines splits a string by newlines
-func splitLines(s string) []string {
-	var lines []string
-	start := 0
-	for i := 0; i < len(s); i++ {
-		if s[i] == '\n' {
-			lines = append(lines, s[start:i])
-			start = i + 1
+		if len(depBatch) >= opts.BatchSize {
+			if err := flushDeps(); err != nil {
+				return err
+			}
 		}
+		if len(labelBatch) >= opts.BatchSize {
+			if err := flushLabels(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to scan JSONL file: %w", err)
 	}
-	if start < len(s) {
-		lines = append(lines, s[start:])
+	if err := flushDeps(); err != nil {
+		return err
 	}
-	return lines
+	return flushLabels()
 }
 
 // randomStatus returns a random status with given open ratio
@@ -0,0 +1,360 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// GenerateOptions controls how a fixture is generated. The zero value
+// produces the same serial behavior as generateIssues.
+type GenerateOptions struct {
+	// Concurrency is the number of workers used to fan out issue creation.
+	// Values <= 1 fall back to serial generation. The effective worker
+	// count is capped at GOMAXPROCS.
+	Concurrency int
+
+	// Progress reports generation progress, one unit per issue created.
+	// Implementations must be safe for concurrent use: the parallel
+	// generator calls Add from multiple workers at once. Defaults to a
+	// no-op.
+	Progress Progress
+}
+
+// LargeSQLiteParallel creates a 10K issue database with realistic patterns,
+// fanning issue creation out across a bounded worker pool.
+func LargeSQLiteParallel(ctx context.Context, store storage.Storage, opts GenerateOptions) error {
+	return generateIssuesParallel(ctx, store, 10000, 42, opts)
+}
+
+// XLargeSQLiteParallel creates a 20K issue database with realistic patterns,
+// fanning issue creation out across a bounded worker pool.
+func XLargeSQLiteParallel(ctx context.Context, store storage.Storage, opts GenerateOptions) error {
+	return generateIssuesParallel(ctx, store, 20000, 43, opts)
+}
+
+// tierTiming records how long a tier of the parallel generator took, for
+// benchmark authors trying to see where setup time is going.
+type tierTiming struct {
+	name     string
+	count    int
+	duration time.Duration
+}
+
+// tierReporter is implemented by Progress implementations that want
+// generateIssuesParallel's per-tier timing summary. Implementations that
+// don't implement it (noopProgress, or a caller-supplied Progress without a
+// ReportTier method) just don't get it — there's no hardcoded stdout fallback.
+type tierReporter interface {
+	ReportTier(name string, count int, d time.Duration)
+}
+
+// generateIssuesParallel is the parallel counterpart to generateIssues. Epics
+// are created and fully committed before features (which reference them) are
+// dispatched, and features before tasks; within each tier, work is
+// parallelized across a bounded worker pool. Cross-link creation runs as a
+// final parallel phase once all tiers exist.
+func generateIssuesParallel(ctx context.Context, store storage.Storage, n int, baseSeed int64, opts GenerateOptions) error {
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 1
+	}
+	if max := runtime.GOMAXPROCS(0); workers > max {
+		workers = max
+	}
+
+	numEpics := n / 10
+	numFeatures := (n * 3) / 10
+	numTasks := n - numEpics - numFeatures
+
+	progress := progressOrDefault(opts.Progress)
+	progress.Start(int64(n))
+	defer progress.Finish()
+
+	var timings []tierTiming
+
+	epicStart := time.Now()
+	epicIssues, err := createTierParallel(ctx, store, numEpics, workers, baseSeed, "epic", func(rng *rand.Rand, i int) (*types.Issue, error) {
+		issue, err := createEpic(ctx, store, rng, i)
+		if err == nil {
+			progress.Add(1)
+		}
+		return issue, err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create epics: %w", err)
+	}
+	timings = append(timings, tierTiming{"epics", numEpics, time.Since(epicStart)})
+
+	featureStart := time.Now()
+	featureIssues, err := createTierParallel(ctx, store, numFeatures, workers, baseSeed+1, "feature", func(rng *rand.Rand, i int) (*types.Issue, error) {
+		parentEpic := epicIssues[i%len(epicIssues)]
+		issue, err := createFeature(ctx, store, rng, i, parentEpic)
+		if err == nil {
+			progress.Add(1)
+		}
+		return issue, err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create features: %w", err)
+	}
+	timings = append(timings, tierTiming{"features", numFeatures, time.Since(featureStart)})
+
+	taskStart := time.Now()
+	taskIssues, err := createTierParallel(ctx, store, numTasks, workers, baseSeed+2, "task", func(rng *rand.Rand, i int) (*types.Issue, error) {
+		parentFeature := featureIssues[i%len(featureIssues)]
+		issue, err := createTask(ctx, store, rng, i, parentFeature)
+		if err == nil {
+			progress.Add(1)
+		}
+		return issue, err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create tasks: %w", err)
+	}
+	timings = append(timings, tierTiming{"tasks", numTasks, time.Since(taskStart)})
+
+	crossLinkStart := time.Now()
+	numCrossLinks := numTasks / 5
+	if err := addCrossLinksParallel(ctx, store, taskIssues, numCrossLinks, workers, baseSeed+3); err != nil {
+		return fmt.Errorf("failed to add cross-links: %w", err)
+	}
+	timings = append(timings, tierTiming{"cross-links", numCrossLinks, time.Since(crossLinkStart)})
+
+	if tr, ok := progress.(tierReporter); ok {
+		for _, t := range timings {
+			tr.ReportTier(t.name, t.count, t.duration)
+		}
+	}
+
+	return nil
+}
+
+// workerRanges splits [0, count) into workers contiguous, non-overlapping
+// ranges (the first count%workers ranges get one extra element), so that
+// which worker owns index i is fixed by (count, workers) alone rather than
+// by goroutine-scheduling order. Shared by createTierParallel and
+// addCrossLinksParallel so both fan work out deterministically.
+func workerRanges(count, workers int) [][2]int {
+	ranges := make([][2]int, workers)
+	base := count / workers
+	extra := count % workers
+	start := 0
+	for w := 0; w < workers; w++ {
+		size := base
+		if w < extra {
+			size++
+		}
+		ranges[w] = [2]int{start, start + size}
+		start += size
+	}
+	return ranges
+}
+
+// createTierParallel creates count issues via fn, fanning the work out
+// across workers goroutines. Each worker is assigned a fixed, contiguous
+// range of indices up front (see workerRanges), and uses its own *rand.Rand
+// seeded from baseSeed plus the worker's ID, so which worker produces issue
+// i's randomized fields is fixed by i alone: two runs with the same
+// baseSeed and worker count produce identical issues regardless of
+// goroutine-scheduling order. Issues are returned in index order.
+func createTierParallel(ctx context.Context, store storage.Storage, count, workers int, baseSeed int64, tier string, fn func(rng *rand.Rand, i int) (*types.Issue, error)) ([]*types.Issue, error) {
+	if count == 0 {
+		return nil, nil
+	}
+	if workers > count {
+		workers = count
+	}
+
+	issues := make([]*types.Issue, count)
+	var firstErr error
+	var errOnce sync.Once
+	var wg sync.WaitGroup
+
+	for w, r := range workerRanges(count, workers) {
+		lo, hi := r[0], r[1]
+
+		wg.Add(1)
+		go func(workerID, lo, hi int) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(baseSeed + int64(workerID)))
+			for i := lo; i < hi; i++ {
+				if ctx.Err() != nil {
+					errOnce.Do(func() { firstErr = ctx.Err() })
+					return
+				}
+				issue, err := fn(rng, i)
+				if err != nil {
+					errOnce.Do(func() { firstErr = fmt.Errorf("failed to create %s %d: %w", tier, i, err) })
+					return
+				}
+				issues[i] = issue
+			}
+		}(w, lo, hi)
+	}
+
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return issues, nil
+}
+
+// addCrossLinksParallel adds blocking cross-links between tasks as a final
+// parallel phase once all tasks exist. Cycle errors are expected and
+// ignored, mirroring the serial generator. Like createTierParallel, each
+// worker is assigned a fixed, contiguous range of indices up front (see
+// workerRanges) so which worker's rand.Rand stream produces a given
+// cross-link is fixed by index alone, keeping the edge set reproducible
+// across runs with the same baseSeed and worker count.
+func addCrossLinksParallel(ctx context.Context, store storage.Storage, taskIssues []*types.Issue, count, workers int, baseSeed int64) error {
+	if count == 0 || len(taskIssues) == 0 {
+		return nil
+	}
+	if workers > count {
+		workers = count
+	}
+
+	var wg sync.WaitGroup
+
+	for w, r := range workerRanges(count, workers) {
+		lo, hi := r[0], r[1]
+
+		wg.Add(1)
+		go func(workerID, lo, hi int) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(baseSeed + int64(workerID)))
+			for i := lo; i < hi; i++ {
+				fromTask := taskIssues[rng.Intn(len(taskIssues))]
+				toTask := taskIssues[rng.Intn(len(taskIssues))]
+				if fromTask.ID == toTask.ID {
+					continue
+				}
+				dep := &types.Dependency{
+					IssueID:     fromTask.ID,
+					DependsOnID: toTask.ID,
+					Type:        types.DepBlocks,
+					CreatedAt:   time.Now(),
+					CreatedBy:   "fixture",
+				}
+				// Ignore cycle errors for cross-links (they're expected).
+				_ = store.AddDependency(ctx, dep, "fixture")
+			}
+		}(w, lo, hi)
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// createEpic builds and persists a single epic issue using rng for all
+// randomized fields, matching the shape produced by generateIssues.
+func createEpic(ctx context.Context, store storage.Storage, rng *rand.Rand, i int) (*types.Issue, error) {
+	issue := &types.Issue{
+		Title:       fmt.Sprintf("%s (Epic %d)", epicTitles[i%len(epicTitles)], i),
+		Description: fmt.Sprintf("Epic for %s", epicTitles[i%len(epicTitles)]),
+		Status:      randomStatus(rng, 0.5),
+		Priority:    randomPriority(rng),
+		IssueType:   types.TypeEpic,
+		Assignee:    commonAssignees[rng.Intn(len(commonAssignees))],
+		CreatedAt:   randomTime(rng, 180),
+		UpdatedAt:   time.Now(),
+	}
+	if issue.Status == types.StatusClosed {
+		closedAt := randomTime(rng, 30)
+		issue.ClosedAt = &closedAt
+	}
+
+	if err := store.CreateIssue(ctx, issue, "fixture"); err != nil {
+		return nil, err
+	}
+	for j := 0; j < rng.Intn(3)+1; j++ {
+		label := commonLabels[rng.Intn(len(commonLabels))]
+		_ = store.AddLabel(ctx, issue.ID, label, "fixture")
+	}
+	return issue, nil
+}
+
+// createFeature builds and persists a single feature issue under parentEpic.
+func createFeature(ctx context.Context, store storage.Storage, rng *rand.Rand, i int, parentEpic *types.Issue) (*types.Issue, error) {
+	issue := &types.Issue{
+		Title:       fmt.Sprintf("%s (Feature %d)", featureTitles[i%len(featureTitles)], i),
+		Description: fmt.Sprintf("Feature under %s", parentEpic.Title),
+		Status:      randomStatus(rng, 0.5),
+		Priority:    randomPriority(rng),
+		IssueType:   types.TypeFeature,
+		Assignee:    commonAssignees[rng.Intn(len(commonAssignees))],
+		CreatedAt:   randomTime(rng, 150),
+		UpdatedAt:   time.Now(),
+	}
+	if issue.Status == types.StatusClosed {
+		closedAt := randomTime(rng, 30)
+		issue.ClosedAt = &closedAt
+	}
+
+	if err := store.CreateIssue(ctx, issue, "fixture"); err != nil {
+		return nil, err
+	}
+
+	dep := &types.Dependency{
+		IssueID:     issue.ID,
+		DependsOnID: parentEpic.ID,
+		Type:        types.DepParentChild,
+		CreatedAt:   time.Now(),
+		CreatedBy:   "fixture",
+	}
+	if err := store.AddDependency(ctx, dep, "fixture"); err != nil {
+		return nil, err
+	}
+
+	for j := 0; j < rng.Intn(3)+1; j++ {
+		label := commonLabels[rng.Intn(len(commonLabels))]
+		_ = store.AddLabel(ctx, issue.ID, label, "fixture")
+	}
+	return issue, nil
+}
+
+// createTask builds and persists a single task issue under parentFeature.
+func createTask(ctx context.Context, store storage.Storage, rng *rand.Rand, i int, parentFeature *types.Issue) (*types.Issue, error) {
+	issue := &types.Issue{
+		Title:       fmt.Sprintf("%s (Task %d)", taskTitles[i%len(taskTitles)], i),
+		Description: fmt.Sprintf("Task under %s", parentFeature.Title),
+		Status:      randomStatus(rng, 0.5),
+		Priority:    randomPriority(rng),
+		IssueType:   types.TypeTask,
+		Assignee:    commonAssignees[rng.Intn(len(commonAssignees))],
+		CreatedAt:   randomTime(rng, 120),
+		UpdatedAt:   time.Now(),
+	}
+	if issue.Status == types.StatusClosed {
+		closedAt := randomTime(rng, 30)
+		issue.ClosedAt = &closedAt
+	}
+
+	if err := store.CreateIssue(ctx, issue, "fixture"); err != nil {
+		return nil, err
+	}
+
+	dep := &types.Dependency{
+		IssueID:     issue.ID,
+		DependsOnID: parentFeature.ID,
+		Type:        types.DepParentChild,
+		CreatedAt:   time.Now(),
+		CreatedBy:   "fixture",
+	}
+	if err := store.AddDependency(ctx, dep, "fixture"); err != nil {
+		return nil, err
+	}
+
+	for j := 0; j < rng.Intn(2)+1; j++ {
+		label := commonLabels[rng.Intn(len(commonLabels))]
+		_ = store.AddLabel(ctx, issue.ID, label, "fixture")
+	}
+	return issue, nil
+}
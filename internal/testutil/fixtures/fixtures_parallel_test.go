@@ -0,0 +1,92 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// TestCreateTierParallelDeterministic asserts that two runs with the same
+// baseSeed and worker count produce byte-identical issue fields at every
+// index, regardless of goroutine-scheduling order. createTierParallel
+// doesn't call store itself (fn closes over it), so a nil store is fine
+// here.
+func TestCreateTierParallelDeterministic(t *testing.T) {
+	const count = 200
+
+	fn := func(rng *rand.Rand, i int) (*types.Issue, error) {
+		return &types.Issue{
+			Title: fmt.Sprintf("%d:%d", i, rng.Int63()),
+		}, nil
+	}
+
+	for _, workers := range []int{1, 2, 3, 8} {
+		workers := workers
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			first, err := createTierParallel(context.Background(), nil, count, workers, 7, "task", fn)
+			if err != nil {
+				t.Fatalf("run 1: %v", err)
+			}
+			second, err := createTierParallel(context.Background(), nil, count, workers, 7, "task", fn)
+			if err != nil {
+				t.Fatalf("run 2: %v", err)
+			}
+
+			for i := range first {
+				if first[i].Title != second[i].Title {
+					t.Fatalf("index %d: run 1 %q != run 2 %q", i, first[i].Title, second[i].Title)
+				}
+			}
+		})
+	}
+}
+
+// TestWorkerRanges covers the partitioning both createTierParallel and
+// addCrossLinksParallel rely on for deterministic, goroutine-order-
+// independent work assignment.
+func TestWorkerRanges(t *testing.T) {
+	for _, tc := range []struct {
+		count, workers int
+	}{
+		{0, 1}, {1, 1}, {200, 1}, {200, 2}, {200, 3}, {200, 8}, {3, 8},
+	} {
+		t.Run(fmt.Sprintf("count=%d,workers=%d", tc.count, tc.workers), func(t *testing.T) {
+			workers := tc.workers
+			if workers > tc.count && tc.count > 0 {
+				workers = tc.count
+			}
+			if workers == 0 {
+				workers = 1
+			}
+			ranges := workerRanges(tc.count, workers)
+			if len(ranges) != workers {
+				t.Fatalf("got %d ranges, want %d", len(ranges), workers)
+			}
+
+			covered := make([]bool, tc.count)
+			wantStart := 0
+			for _, r := range ranges {
+				lo, hi := r[0], r[1]
+				if lo != wantStart {
+					t.Fatalf("range %v: expected to start at %d", r, wantStart)
+				}
+				if lo > hi {
+					t.Fatalf("range %v: lo > hi", r)
+				}
+				for i := lo; i < hi; i++ {
+					if covered[i] {
+						t.Fatalf("index %d covered by more than one range", i)
+					}
+					covered[i] = true
+				}
+				wantStart = hi
+			}
+			if wantStart != tc.count {
+				t.Fatalf("ranges cover up to %d, want %d", wantStart, tc.count)
+			}
+		})
+	}
+}
@@ -0,0 +1,453 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// FixtureProfileEnvVar is the environment variable beadsbench sets to tell
+// a benchmark run which FixtureProfile to build its setup data under (see
+// ProfileFromEnv).
+const FixtureProfileEnvVar = "BEADS_BENCH_FIXTURE_PROFILE"
+
+// profilesByName maps the -profiles names beadsbench accepts on the command
+// line to the FixtureProfile each benchmark should build against.
+var profilesByName = map[string]FixtureProfile{
+	"default":         ProfileFlat,
+	"flat":            ProfileFlat,
+	"deep-tree":       ProfileDeepTree,
+	"high-contention": ProfileHighContention,
+	"mostly-closed":   ProfileMostlyClosed,
+}
+
+// ProfileByName looks up a FixtureProfile by the name used on beadsbench's
+// -profiles flag, returning false if name isn't recognized.
+func ProfileByName(name string) (FixtureProfile, bool) {
+	profile, ok := profilesByName[name]
+	return profile, ok
+}
+
+// ProfileFromEnv returns the FixtureProfile named by FixtureProfileEnvVar,
+// falling back to ProfileFlat when the variable is unset or names an
+// unrecognized profile. It lets a benchmark setup helper build its fixture
+// under the profile beadsbench requested; no benchmark setup helper in this
+// repository calls it, so they all build the same default fixture.
+func ProfileFromEnv() FixtureProfile {
+	if profile, ok := ProfileByName(os.Getenv(FixtureProfileEnvVar)); ok {
+		return profile
+	}
+	return ProfileFlat
+}
+
+// TierRatios describes what fraction of a fixture's issues fall into each
+// tier. The three ratios must sum to 1.0.
+type TierRatios struct {
+	Epic    float64
+	Feature float64
+	Task    float64
+}
+
+// FixtureProfile describes the shape of a generated fixture: how many
+// issues, how they're split across tiers, how priorities and open/closed
+// status are distributed, how many labels each tier gets, and how densely
+// the dependency graph is linked.
+type FixtureProfile struct {
+	// Name identifies the profile; Generate includes it in validation errors.
+	Name string
+
+	// IssueCount is the total number of issues to generate.
+	IssueCount int
+
+	// Tiers is the epic/feature/task split. Ratios must sum to 1.0.
+	Tiers TierRatios
+
+	// PriorityWeights is a 5-bucket histogram (P0..P4) used to draw each
+	// issue's priority. It need not sum to 100; it's normalized.
+	PriorityWeights [5]int
+
+	// OpenRatio is the fraction of issues left open (vs. closed).
+	OpenRatio float64
+
+	// LabelsPerTier bounds how many labels are attached to each issue in a
+	// tier (a random count in [1, LabelsPerTier[tier]] is chosen per issue).
+	LabelsPerTier map[types.IssueType]int
+
+	// CrossLinkProbability is, per task, the probability it gets a blocking
+	// cross-link to another task. Must be in [0, 1].
+	CrossLinkProbability float64
+
+	// MaxFanOut bounds how many outgoing blocking dependencies a single task
+	// may accumulate from cross-linking.
+	MaxFanOut int
+
+	// MaxFanIn bounds how many incoming blocking dependencies a single task
+	// may accumulate from cross-linking.
+	MaxFanIn int
+
+	// TreeDepth is the number of feature-level hops inserted between an
+	// epic and its tasks before tasks attach; 1 reproduces the classic
+	// epic -> feature -> task shape, higher values build deeper chains of
+	// features under the epic.
+	TreeDepth int
+}
+
+// ProfileFlat is a shallow, wide graph: few cross-links, mostly open work.
+// It characterizes GetReadyWork when there's little contention between
+// issues.
+var ProfileFlat = FixtureProfile{
+	Name:                  "flat",
+	IssueCount:            10000,
+	Tiers:                 TierRatios{Epic: 0.10, Feature: 0.30, Task: 0.60},
+	PriorityWeights:       [5]int{5, 15, 50, 25, 5},
+	OpenRatio:             0.7,
+	LabelsPerTier:         map[types.IssueType]int{types.TypeEpic: 3, types.TypeFeature: 3, types.TypeTask: 2},
+	CrossLinkProbability:  0.02,
+	MaxFanOut:             2,
+	MaxFanIn:              2,
+	TreeDepth:             1,
+}
+
+// ProfileDeepTree stacks several feature-level hops between epics and tasks,
+// characterizing GetReadyWork on long dependency chains.
+var ProfileDeepTree = FixtureProfile{
+	Name:                  "deep-tree",
+	IssueCount:            10000,
+	Tiers:                 TierRatios{Epic: 0.05, Feature: 0.35, Task: 0.60},
+	PriorityWeights:       [5]int{5, 15, 50, 25, 5},
+	OpenRatio:             0.5,
+	LabelsPerTier:         map[types.IssueType]int{types.TypeEpic: 3, types.TypeFeature: 3, types.TypeTask: 2},
+	CrossLinkProbability:  0.05,
+	MaxFanOut:             2,
+	MaxFanIn:              2,
+	TreeDepth:             5,
+}
+
+// ProfileHighContention maximizes cross-linking so many tasks block each
+// other, characterizing GetReadyWork when the ready set is small relative to
+// total open work.
+var ProfileHighContention = FixtureProfile{
+	Name:                  "high-contention",
+	IssueCount:            10000,
+	Tiers:                 TierRatios{Epic: 0.10, Feature: 0.30, Task: 0.60},
+	PriorityWeights:       [5]int{5, 15, 50, 25, 5},
+	OpenRatio:             0.6,
+	LabelsPerTier:         map[types.IssueType]int{types.TypeEpic: 3, types.TypeFeature: 3, types.TypeTask: 2},
+	CrossLinkProbability:  0.35,
+	MaxFanOut:             6,
+	MaxFanIn:              6,
+	TreeDepth:             1,
+}
+
+// ProfileMostlyClosed leaves only a small fraction of issues open,
+// characterizing GetReadyWork and SearchIssues on a mature, mostly-completed
+// backlog.
+var ProfileMostlyClosed = FixtureProfile{
+	Name:                  "mostly-closed",
+	IssueCount:            10000,
+	Tiers:                 TierRatios{Epic: 0.10, Feature: 0.30, Task: 0.60},
+	PriorityWeights:       [5]int{5, 15, 50, 25, 5},
+	OpenRatio:             0.1,
+	LabelsPerTier:         map[types.IssueType]int{types.TypeEpic: 3, types.TypeFeature: 3, types.TypeTask: 2},
+	CrossLinkProbability:  0.02,
+	MaxFanOut:             2,
+	MaxFanIn:              2,
+	TreeDepth:             1,
+}
+
+// Validate checks that the profile's invariants hold: tier ratios sum to
+// 1.0, every tier with a positive ratio gets at least one issue, every tier
+// that needs a parent has one available, and CrossLinkProbability is a valid
+// probability.
+func (p FixtureProfile) Validate() error {
+	const epsilon = 1e-6
+	sum := p.Tiers.Epic + p.Tiers.Feature + p.Tiers.Task
+	if sum < 1.0-epsilon || sum > 1.0+epsilon {
+		return fmt.Errorf("tier ratios must sum to 1.0, got %.6f", sum)
+	}
+	if p.CrossLinkProbability < 0 || p.CrossLinkProbability > 1 {
+		return fmt.Errorf("cross-link probability must be in [0, 1], got %.6f", p.CrossLinkProbability)
+	}
+	if p.IssueCount <= 0 {
+		return fmt.Errorf("issue count must be positive, got %d", p.IssueCount)
+	}
+	if p.TreeDepth <= 0 {
+		return fmt.Errorf("tree depth must be positive, got %d", p.TreeDepth)
+	}
+	// Generate's first feature level attaches only to epics, and its task
+	// loop attaches only to the deepest feature level (falling back to
+	// epics when TreeDepth's leaf level is empty). A tier with issues but no
+	// possible parent would make Generate divide by zero picking one, so
+	// reject that combination here instead.
+	if p.Tiers.Feature > 0 && p.Tiers.Epic <= 0 {
+		return fmt.Errorf("feature ratio %.6f requires a positive epic ratio to attach to", p.Tiers.Feature)
+	}
+	if p.Tiers.Task > 0 && p.Tiers.Epic <= 0 && p.Tiers.Feature <= 0 {
+		return fmt.Errorf("task ratio %.6f requires a positive epic or feature ratio to attach to", p.Tiers.Task)
+	}
+	// tierCounts reserves one issue for every tier with a positive ratio
+	// before distributing the rest proportionally; that reservation can't
+	// be honored if IssueCount is smaller than the number of positive-ratio
+	// tiers.
+	positiveTiers := 0
+	for _, ratio := range []float64{p.Tiers.Epic, p.Tiers.Feature, p.Tiers.Task} {
+		if ratio > 0 {
+			positiveTiers++
+		}
+	}
+	if p.IssueCount < positiveTiers {
+		return fmt.Errorf("issue count %d is too small to give each of the %d positive-ratio tiers at least one issue", p.IssueCount, positiveTiers)
+	}
+	return nil
+}
+
+// tierCounts derives how many epics, features, and tasks to generate for n
+// total issues. It reserves one issue for every tier with a positive ratio
+// up front (Validate rejects profiles where n is too small to afford that),
+// then distributes the rest proportionally, so the three counts always sum
+// to exactly n instead of independently rounding up and overshooting it.
+func (p FixtureProfile) tierCounts() (epics, features, tasks int) {
+	n := p.IssueCount
+	reserved := 0
+	if p.Tiers.Epic > 0 {
+		reserved++
+	}
+	if p.Tiers.Feature > 0 {
+		reserved++
+	}
+	if p.Tiers.Task > 0 {
+		reserved++
+	}
+
+	remaining := n - reserved
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	epics = int(float64(remaining) * p.Tiers.Epic)
+	features = int(float64(remaining) * p.Tiers.Feature)
+	tasks = remaining - epics - features
+
+	if p.Tiers.Epic > 0 {
+		epics++
+	}
+	if p.Tiers.Feature > 0 {
+		features++
+	}
+	if p.Tiers.Task > 0 {
+		tasks++
+	}
+	return epics, features, tasks
+}
+
+// featureLevelParents returns the issues a new feature at featureLevels[level]
+// should attach to: the nearest earlier level that isn't empty, or epics if
+// no earlier level has any issues yet. Levels can end up empty because
+// levelCount rounds down to zero for a small remaining budget; walking back
+// to the nearest non-empty level (instead of always using level-1) means
+// that rounding never strands a later level with no parent to attach to.
+func featureLevelParents(featureLevels [][]*types.Issue, level int, epics []*types.Issue) []*types.Issue {
+	for l := level - 1; l >= 0; l-- {
+		if len(featureLevels[l]) > 0 {
+			return featureLevels[l]
+		}
+	}
+	return epics
+}
+
+// Generate builds a fixture in store according to profile, seeded
+// deterministically from seed.
+func Generate(ctx context.Context, store storage.Storage, profile FixtureProfile, seed int64) error {
+	if err := profile.Validate(); err != nil {
+		return fmt.Errorf("invalid fixture profile %q: %w", profile.Name, err)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	numEpics, numFeatures, numTasks := profile.tierCounts()
+
+	epicIssues := make([]*types.Issue, 0, numEpics)
+	featureIssues := make([]*types.Issue, 0, numFeatures)
+	taskIssues := make([]*types.Issue, 0, numTasks)
+
+	for i := 0; i < numEpics; i++ {
+		issue, err := createProfileIssue(ctx, store, profile, rng, types.TypeEpic, i, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create epic: %w", err)
+		}
+		epicIssues = append(epicIssues, issue)
+	}
+
+	// Build TreeDepth chains of features under each epic; only the deepest
+	// tier of features is used as a task parent, so a TreeDepth of 1
+	// reproduces the classic epic -> feature -> task shape.
+	featureLevels := make([][]*types.Issue, profile.TreeDepth)
+	remainingFeatures := numFeatures
+	for level := 0; level < profile.TreeDepth; level++ {
+		levelCount := remainingFeatures / (profile.TreeDepth - level)
+		remainingFeatures -= levelCount
+
+		parents := featureLevelParents(featureLevels, level, epicIssues)
+
+		for i := 0; i < levelCount; i++ {
+			parent := parents[i%len(parents)]
+			issue, err := createProfileIssue(ctx, store, profile, rng, types.TypeFeature, i, parent)
+			if err != nil {
+				return fmt.Errorf("failed to create feature: %w", err)
+			}
+			featureLevels[level] = append(featureLevels[level], issue)
+			featureIssues = append(featureIssues, issue)
+		}
+	}
+
+	leafFeatures := featureLevels[profile.TreeDepth-1]
+	if len(leafFeatures) == 0 {
+		leafFeatures = epicIssues
+	}
+
+	for i := 0; i < numTasks; i++ {
+		parent := leafFeatures[i%len(leafFeatures)]
+		issue, err := createProfileIssue(ctx, store, profile, rng, types.TypeTask, i, parent)
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+		taskIssues = append(taskIssues, issue)
+	}
+
+	return addProfileCrossLinks(ctx, store, profile, rng, taskIssues)
+}
+
+// createProfileIssue builds and persists a single issue of kind tier,
+// attached to parent (nil for epics), using profile's distributions.
+func createProfileIssue(ctx context.Context, store storage.Storage, profile FixtureProfile, rng *rand.Rand, tier types.IssueType, i int, parent *types.Issue) (*types.Issue, error) {
+	var title, description string
+	var maxDaysAgo int
+	switch tier {
+	case types.TypeEpic:
+		title = fmt.Sprintf("%s (Epic %d)", epicTitles[i%len(epicTitles)], i)
+		description = fmt.Sprintf("Epic for %s", epicTitles[i%len(epicTitles)])
+		maxDaysAgo = 180
+	case types.TypeFeature:
+		title = fmt.Sprintf("%s (Feature %d)", featureTitles[i%len(featureTitles)], i)
+		description = fmt.Sprintf("Feature under %s", parent.Title)
+		maxDaysAgo = 150
+	default:
+		title = fmt.Sprintf("%s (Task %d)", taskTitles[i%len(taskTitles)], i)
+		description = fmt.Sprintf("Task under %s", parent.Title)
+		maxDaysAgo = 120
+	}
+
+	issue := &types.Issue{
+		Title:       title,
+		Description: description,
+		Status:      profileStatus(rng, profile.OpenRatio),
+		Priority:    profilePriority(rng, profile.PriorityWeights),
+		IssueType:   tier,
+		Assignee:    commonAssignees[rng.Intn(len(commonAssignees))],
+		CreatedAt:   randomTime(rng, maxDaysAgo),
+		UpdatedAt:   time.Now(),
+	}
+	if issue.Status == types.StatusClosed {
+		closedAt := randomTime(rng, 30)
+		issue.ClosedAt = &closedAt
+	}
+
+	if err := store.CreateIssue(ctx, issue, "fixture"); err != nil {
+		return nil, err
+	}
+
+	if parent != nil {
+		dep := &types.Dependency{
+			IssueID:     issue.ID,
+			DependsOnID: parent.ID,
+			Type:        types.DepParentChild,
+			CreatedAt:   time.Now(),
+			CreatedBy:   "fixture",
+		}
+		if err := store.AddDependency(ctx, dep, "fixture"); err != nil {
+			return nil, err
+		}
+	}
+
+	maxLabels := profile.LabelsPerTier[tier]
+	if maxLabels > 0 {
+		for j := 0; j < rng.Intn(maxLabels)+1; j++ {
+			label := commonLabels[rng.Intn(len(commonLabels))]
+			_ = store.AddLabel(ctx, issue.ID, label, "fixture")
+		}
+	}
+
+	return issue, nil
+}
+
+// addProfileCrossLinks adds blocking cross-links between tasks according to
+// profile.CrossLinkProbability, respecting MaxFanOut/MaxFanIn.
+func addProfileCrossLinks(ctx context.Context, store storage.Storage, profile FixtureProfile, rng *rand.Rand, taskIssues []*types.Issue) error {
+	if len(taskIssues) == 0 || profile.CrossLinkProbability <= 0 {
+		return nil
+	}
+
+	fanOut := make(map[string]int)
+	fanIn := make(map[string]int)
+
+	for _, fromTask := range taskIssues {
+		if rng.Float64() >= profile.CrossLinkProbability {
+			continue
+		}
+		toTask := taskIssues[rng.Intn(len(taskIssues))]
+		if fromTask.ID == toTask.ID {
+			continue
+		}
+		if profile.MaxFanOut > 0 && fanOut[fromTask.ID] >= profile.MaxFanOut {
+			continue
+		}
+		if profile.MaxFanIn > 0 && fanIn[toTask.ID] >= profile.MaxFanIn {
+			continue
+		}
+
+		dep := &types.Dependency{
+			IssueID:     fromTask.ID,
+			DependsOnID: toTask.ID,
+			Type:        types.DepBlocks,
+			CreatedAt:   time.Now(),
+			CreatedBy:   "fixture",
+		}
+		// Ignore cycle errors for cross-links (they're expected).
+		if err := store.AddDependency(ctx, dep, "fixture"); err == nil {
+			fanOut[fromTask.ID]++
+			fanIn[toTask.ID]++
+		}
+	}
+
+	return nil
+}
+
+// profileStatus returns a random status with the given open ratio.
+func profileStatus(rng *rand.Rand, openRatio float64) types.Status {
+	return randomStatus(rng, openRatio)
+}
+
+// profilePriority draws a priority in [0, 4] from a 5-bucket weight
+// histogram. Buckets with zero weight are never drawn.
+func profilePriority(rng *rand.Rand, weights [5]int) int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return 2
+	}
+	r := rng.Intn(total)
+	cum := 0
+	for priority, w := range weights {
+		cum += w
+		if r < cum {
+			return priority
+		}
+	}
+	return len(weights) - 1
+}
@@ -0,0 +1,184 @@
+package fixtures
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFixtureProfileValidate(t *testing.T) {
+	base := ProfileFlat // copy: Tiers{Epic: 0.10, Feature: 0.30, Task: 0.60}
+
+	t.Run("accepts the shipped presets", func(t *testing.T) {
+		for _, p := range []FixtureProfile{ProfileFlat, ProfileDeepTree, ProfileHighContention, ProfileMostlyClosed} {
+			if err := p.Validate(); err != nil {
+				t.Errorf("profile %q: unexpected error: %v", p.Name, err)
+			}
+		}
+	})
+
+	t.Run("rejects ratios that don't sum to 1.0", func(t *testing.T) {
+		p := base
+		p.Tiers = TierRatios{Epic: 0.1, Feature: 0.1, Task: 0.1}
+		if err := p.Validate(); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects a zero-epic profile with a positive feature ratio", func(t *testing.T) {
+		// This is the combination that used to make Generate divide by
+		// zero: Feature issues are requested but have no epic to attach to.
+		p := base
+		p.Tiers = TierRatios{Epic: 0, Feature: 0.3, Task: 0.7}
+		if err := p.Validate(); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects a zero-epic zero-feature profile with a positive task ratio", func(t *testing.T) {
+		p := base
+		p.Tiers = TierRatios{Epic: 0, Feature: 0, Task: 1}
+		if err := p.Validate(); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("allows a zero-feature profile when tasks attach directly to epics", func(t *testing.T) {
+		p := base
+		p.Tiers = TierRatios{Epic: 0.3, Feature: 0, Task: 0.7}
+		p.TreeDepth = 1
+		if err := p.Validate(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("rejects an issue count smaller than the number of positive-ratio tiers", func(t *testing.T) {
+		// tierCounts reserves one issue per positive-ratio tier; with all
+		// three tiers positive, IssueCount must be at least 3.
+		p := base
+		p.IssueCount = 2
+		p.Tiers = TierRatios{Epic: 0.9, Feature: 0.05, Task: 0.05}
+		if err := p.Validate(); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestFixtureProfileTierCounts(t *testing.T) {
+	p := ProfileFlat
+	p.IssueCount = 100
+	epics, features, tasks := p.tierCounts()
+	if epics+features+tasks != p.IssueCount {
+		t.Errorf("tier counts %d+%d+%d don't sum to IssueCount %d", epics, features, tasks, p.IssueCount)
+	}
+
+	// A tiny count with a nonzero ratio still gets at least one issue.
+	p.IssueCount = 3
+	p.Tiers = TierRatios{Epic: 0.01, Feature: 0.01, Task: 0.98}
+	epics, features, tasks = p.tierCounts()
+	if epics < 1 || features < 1 {
+		t.Errorf("expected epics and features >= 1, got epics=%d features=%d", epics, features)
+	}
+	if epics+features+tasks != p.IssueCount {
+		t.Errorf("tier counts %d+%d+%d don't sum to IssueCount %d", epics, features, tasks, p.IssueCount)
+	}
+}
+
+// TestFixtureProfileTierCountsNeverOvershoots covers the case a prior
+// version of tierCounts got wrong: clamping each tier up to at least 1
+// independently, without reserving for it, could make the three counts sum
+// to more than IssueCount.
+func TestFixtureProfileTierCountsNeverOvershoots(t *testing.T) {
+	p := ProfileFlat
+	p.Tiers = TierRatios{Epic: 0.9, Feature: 0.05, Task: 0.05}
+	for n := 3; n <= 20; n++ {
+		p.IssueCount = n
+		epics, features, tasks := p.tierCounts()
+		if sum := epics + features + tasks; sum != n {
+			t.Errorf("IssueCount=%d: tier counts %d+%d+%d = %d, want %d", n, epics, features, tasks, sum, n)
+		}
+	}
+}
+
+func TestFeatureLevelParents(t *testing.T) {
+	epics := []*types.Issue{{Title: "epic"}}
+	level0 := []*types.Issue{{Title: "l0"}}
+
+	t.Run("falls back to epics when no earlier level has issues", func(t *testing.T) {
+		levels := [][]*types.Issue{nil, nil, nil}
+		if got := featureLevelParents(levels, 2, epics); len(got) == 0 || got[0] != epics[0] {
+			t.Errorf("expected fallback to epics, got %v", got)
+		}
+	})
+
+	t.Run("skips an empty level to find the nearest non-empty one", func(t *testing.T) {
+		levels := [][]*types.Issue{level0, nil, nil}
+		if got := featureLevelParents(levels, 2, epics); len(got) == 0 || got[0] != level0[0] {
+			t.Errorf("expected level 0, got %v", got)
+		}
+	})
+
+	t.Run("uses the immediately preceding level when it's non-empty", func(t *testing.T) {
+		level1 := []*types.Issue{{Title: "l1"}}
+		levels := [][]*types.Issue{level0, level1, nil}
+		if got := featureLevelParents(levels, 2, epics); len(got) == 0 || got[0] != level1[0] {
+			t.Errorf("expected level 1, got %v", got)
+		}
+	})
+}
+
+func TestProfilePriority(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	t.Run("never draws a zero-weight bucket", func(t *testing.T) {
+		weights := [5]int{0, 10, 0, 10, 0}
+		for i := 0; i < 200; i++ {
+			p := profilePriority(rng, weights)
+			if p != 1 && p != 3 {
+				t.Fatalf("drew priority %d from a zero-weight bucket", p)
+			}
+		}
+	})
+
+	t.Run("falls back to P2 when all weights are zero", func(t *testing.T) {
+		if p := profilePriority(rng, [5]int{0, 0, 0, 0, 0}); p != 2 {
+			t.Errorf("expected fallback priority 2, got %d", p)
+		}
+	})
+}
+
+func TestProfileByName(t *testing.T) {
+	cases := map[string]FixtureProfile{
+		"flat":            ProfileFlat,
+		"deep-tree":       ProfileDeepTree,
+		"high-contention": ProfileHighContention,
+		"mostly-closed":   ProfileMostlyClosed,
+	}
+	for name, want := range cases {
+		got, ok := ProfileByName(name)
+		if !ok {
+			t.Errorf("ProfileByName(%q): not found", name)
+			continue
+		}
+		if got.Name != want.Name {
+			t.Errorf("ProfileByName(%q) = %q, want %q", name, got.Name, want.Name)
+		}
+	}
+
+	if _, ok := ProfileByName("not-a-real-profile"); ok {
+		t.Error("expected ProfileByName to reject an unknown name")
+	}
+}
+
+func TestProfileFromEnvFallsBackToFlat(t *testing.T) {
+	t.Setenv(FixtureProfileEnvVar, "not-a-real-profile")
+	if got := ProfileFromEnv(); got.Name != ProfileFlat.Name {
+		t.Errorf("ProfileFromEnv() = %q, want fallback %q", got.Name, ProfileFlat.Name)
+	}
+
+	t.Setenv(FixtureProfileEnvVar, "deep-tree")
+	if got := ProfileFromEnv(); got.Name != ProfileDeepTree.Name {
+		t.Errorf("ProfileFromEnv() = %q, want %q", got.Name, ProfileDeepTree.Name)
+	}
+}
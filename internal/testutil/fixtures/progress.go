@@ -0,0 +1,214 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Progress reports fixture generation progress. Implementations must be
+// safe for concurrent use: Add is called from the parallel workers in
+// fixtures_parallel.go as well as from the serial generators.
+type Progress interface {
+	// Start begins a new stage of total units of work.
+	Start(total int64)
+	// Add reports n additional units of work completed.
+	Add(n int64)
+	// Finish marks the current stage complete.
+	Finish()
+}
+
+// noopProgress discards all progress reports. It's the default used when a
+// caller doesn't configure a Progress, so tests stay quiet by default.
+type noopProgress struct{}
+
+func (noopProgress) Start(total int64) {}
+func (noopProgress) Add(n int64)       {}
+func (noopProgress) Finish()           {}
+
+// progressOrDefault returns p, or noopProgress{} if p is nil.
+func progressOrDefault(p Progress) Progress {
+	if p == nil {
+		return noopProgress{}
+	}
+	return p
+}
+
+// TerminalProgress renders a live progress bar showing count, percentage,
+// elapsed time, ETA, and issues/sec. When w isn't backed by a terminal it
+// falls back to periodic line output every 10%, the same cadence the
+// original fmt.Printf-based progress used.
+type TerminalProgress struct {
+	Stage string
+
+	w     io.Writer
+	isTTY bool
+
+	mu      sync.Mutex
+	total   int64
+	done    int64
+	started time.Time
+	lastPct int
+}
+
+// NewTerminalProgress creates a TerminalProgress for stage, writing to w
+// (typically os.Stderr).
+func NewTerminalProgress(stage string, w io.Writer) *TerminalProgress {
+	isTTY := false
+	if f, ok := w.(*os.File); ok {
+		if info, err := f.Stat(); err == nil {
+			isTTY = info.Mode()&os.ModeCharDevice != 0
+		}
+	}
+	return &TerminalProgress{Stage: stage, w: w, isTTY: isTTY, lastPct: -1}
+}
+
+func (p *TerminalProgress) Start(total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.total = total
+	p.done = 0
+	p.started = time.Now()
+	p.lastPct = -1
+}
+
+func (p *TerminalProgress) Add(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done += n
+	if p.total <= 0 {
+		return
+	}
+
+	pct := int(p.done * 100 / p.total)
+	if p.isTTY {
+		p.render(pct)
+		return
+	}
+	if pct >= p.lastPct+10 {
+		p.render(pct)
+		p.lastPct = pct
+	}
+}
+
+func (p *TerminalProgress) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.render(100)
+	if p.isTTY {
+		fmt.Fprintln(p.w)
+	}
+}
+
+// ReportTier writes a one-line summary of a completed fixture tier (e.g.
+// "epics: 1000 issues in 1.2s (833 issues/sec)"). It implements the
+// tierReporter interface generateIssuesParallel type-asserts for.
+func (p *TerminalProgress) ReportTier(name string, count int, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	rate := float64(count) / d.Seconds()
+	fmt.Fprintf(p.w, "  %s: %d issues in %s (%.0f issues/sec)\n", name, count, d.Round(time.Millisecond), rate)
+}
+
+// render must be called with p.mu held.
+func (p *TerminalProgress) render(pct int) {
+	elapsed := time.Since(p.started)
+	rate := float64(p.done) / elapsed.Seconds()
+	var eta time.Duration
+	if rate > 0 {
+		eta = time.Duration(float64(p.total-p.done)/rate) * time.Second
+	}
+
+	line := fmt.Sprintf("  %s: %d%% (%d/%d) elapsed=%s eta=%s rate=%.0f/s",
+		p.Stage, pct, p.done, p.total, elapsed.Round(time.Second), eta.Round(time.Second), rate)
+
+	if p.isTTY {
+		fmt.Fprintf(p.w, "\r%s", line)
+	} else {
+		fmt.Fprintln(p.w, line)
+	}
+}
+
+// progressEvent is the structured event LogProgress emits.
+type progressEvent struct {
+	Stage   string  `json:"stage"`
+	Done    int64   `json:"done"`
+	Total   int64   `json:"total"`
+	Elapsed float64 `json:"elapsed"`
+}
+
+// tierEvent is the structured event LogProgress.ReportTier emits.
+type tierEvent struct {
+	Tier       string  `json:"tier"`
+	Count      int     `json:"count"`
+	Seconds    float64 `json:"seconds"`
+	IssuesPerS float64 `json:"issues_per_sec"`
+}
+
+// LogProgress emits one JSON progressEvent per Start/Add/Finish call to w,
+// for callers that want structured logs instead of a terminal bar.
+type LogProgress struct {
+	Stage string
+
+	w io.Writer
+
+	mu      sync.Mutex
+	total   int64
+	done    int64
+	started time.Time
+}
+
+// NewLogProgress creates a LogProgress for stage, writing JSON events to w.
+func NewLogProgress(stage string, w io.Writer) *LogProgress {
+	return &LogProgress{Stage: stage, w: w}
+}
+
+func (p *LogProgress) Start(total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.total = total
+	p.done = 0
+	p.started = time.Now()
+	p.emit()
+}
+
+func (p *LogProgress) Add(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done += n
+	p.emit()
+}
+
+func (p *LogProgress) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.emit()
+}
+
+// ReportTier emits a structured tierEvent for a completed fixture tier. It
+// implements the tierReporter interface generateIssuesParallel type-asserts
+// for.
+func (p *LogProgress) ReportTier(name string, count int, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_ = json.NewEncoder(p.w).Encode(tierEvent{
+		Tier:       name,
+		Count:      count,
+		Seconds:    d.Seconds(),
+		IssuesPerS: float64(count) / d.Seconds(),
+	})
+}
+
+// emit must be called with p.mu held.
+func (p *LogProgress) emit() {
+	ev := progressEvent{
+		Stage:   p.Stage,
+		Done:    p.done,
+		Total:   p.total,
+		Elapsed: time.Since(p.started).Seconds(),
+	}
+	_ = json.NewEncoder(p.w).Encode(ev)
+}